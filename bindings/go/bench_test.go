@@ -0,0 +1,51 @@
+package tree_sitter_notebook_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	tree_sitter_notebook "github.com/koalazub/nothelix/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// largeNotebook synthesizes a multi-megabyte Jupytext percent-format notebook
+// rather than checking in a multi-megabyte fixture: enough code and markdown
+// cells, each with a non-trivial body, to push the source well past 1MB.
+func largeNotebook(cellCount int) []byte {
+	var b strings.Builder
+	for i := 0; i < cellCount; i++ {
+		if i%2 == 0 {
+			fmt.Fprintf(&b, "# %%%% [markdown]\n# ## Section %d\n#\n# %s\n\n", i, strings.Repeat("prose ", 64))
+		} else {
+			fmt.Fprintf(&b, "# %%%%\n")
+			for line := 0; line < 32; line++ {
+				fmt.Fprintf(&b, "value_%d_%d = %d\n", i, line, line)
+			}
+			b.WriteString("\n")
+		}
+	}
+	return []byte(b.String())
+}
+
+func BenchmarkParseLargeNotebook(b *testing.B) {
+	src := largeNotebook(4400)
+	if len(src) < 1<<20 {
+		b.Fatalf("fixture is only %d bytes, want at least 1MB to be a meaningful benchmark", len(src))
+	}
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	if err := parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_notebook.Language())); err != nil {
+		b.Fatalf("SetLanguage: %v", err)
+	}
+
+	b.SetBytes(int64(len(src)))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		tree := parser.ParseCtx(context.Background(), src, nil)
+		tree.Close()
+	}
+}
@@ -0,0 +1,18 @@
+package tree_sitter_notebook
+
+// #cgo CFLAGS: -std=c11 -fPIC
+// #include "../../src/parser.c"
+// #include "../../src/scanner.c"
+// typedef struct TSLanguage TSLanguage;
+// extern const TSLanguage *tree_sitter_notebook(void);
+import "C"
+
+import "unsafe"
+
+// Language returns the tree-sitter language for the notebook block grammar.
+//
+// For the companion inline grammar (prose inside a markdown cell), see
+// github.com/koalazub/nothelix/notebook-inline/bindings/go.
+func Language() unsafe.Pointer {
+	return unsafe.Pointer(C.tree_sitter_notebook())
+}
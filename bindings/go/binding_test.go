@@ -1,10 +1,14 @@
 package tree_sitter_notebook_test
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
-	tree_sitter "github.com/tree-sitter/go-tree-sitter"
 	tree_sitter_notebook "github.com/koalazub/nothelix/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
 )
 
 func TestCanLoadGrammar(t *testing.T) {
@@ -13,3 +17,128 @@ func TestCanLoadGrammar(t *testing.T) {
 		t.Errorf("Error loading Notebook grammar")
 	}
 }
+
+func newParser(t *testing.T) *tree_sitter.Parser {
+	t.Helper()
+	parser := tree_sitter.NewParser()
+	t.Cleanup(parser.Close)
+	if err := parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_notebook.Language())); err != nil {
+		t.Fatalf("SetLanguage: %v", err)
+	}
+	return parser
+}
+
+func readCorpusFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	src, err := os.ReadFile(filepath.Join("..", "..", "test", "corpus", name))
+	if err != nil {
+		t.Fatalf("reading fixture %s: %v", name, err)
+	}
+	return src
+}
+
+func TestParseCorpusFixtures(t *testing.T) {
+	tests := []struct {
+		fixture   string
+		wantCells int
+	}{
+		{fixture: "jupytext.py", wantCells: 3},
+		{fixture: "quarto.qmd", wantCells: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			parser := newParser(t)
+			src := readCorpusFixture(t, tt.fixture)
+
+			tree := parser.ParseCtx(context.Background(), src, nil)
+			defer tree.Close()
+
+			root := tree.RootNode()
+			if root.HasError() {
+				t.Fatalf("unexpected ERROR node:\n%s", root.ToSexp())
+			}
+			if root.Kind() != "notebook" {
+				t.Fatalf("root kind = %q, want notebook", root.Kind())
+			}
+
+			sexp := root.ToSexp()
+			if got := strings.Count(sexp, "(cell "); got != tt.wantCells {
+				t.Errorf("%s: got %d cells in %s, want %d", tt.fixture, got, sexp, tt.wantCells)
+			}
+		})
+	}
+}
+
+const cellKindQuery = `(cell kind: (cell_kind) @kind)`
+
+// Only the Jupytext percent format spells a cell's kind out explicitly - a
+// bare "# %%" code cell has no cell_kind node at all, so this query is
+// expected to match just the one "[markdown]" cell in jupytext.py, not all
+// three.
+func TestQueryCapturesCellKinds(t *testing.T) {
+	language := tree_sitter.NewLanguage(tree_sitter_notebook.Language())
+
+	query, err := tree_sitter.NewQuery(language, cellKindQuery)
+	if err != nil {
+		t.Fatalf("compiling query: %v", err)
+	}
+	defer query.Close()
+
+	parser := newParser(t)
+	src := readCorpusFixture(t, "jupytext.py")
+
+	tree := parser.ParseCtx(context.Background(), src, nil)
+	defer tree.Close()
+
+	cursor := tree_sitter.NewQueryCursor()
+	defer cursor.Close()
+
+	var kinds []string
+	matches := cursor.Matches(query, tree.RootNode(), src)
+	for match := matches.Next(); match != nil; match = matches.Next() {
+		for _, capture := range match.Captures {
+			kinds = append(kinds, capture.Node.Utf8Text(src))
+		}
+	}
+
+	want := []string{"markdown"}
+	if len(kinds) != len(want) {
+		t.Fatalf("got %d @kind captures (%v), want %d", len(kinds), kinds, len(want))
+	}
+	for i, kind := range kinds {
+		if kind != want[i] {
+			t.Errorf("capture %d = %q, want %q", i, kind, want[i])
+		}
+	}
+}
+
+// TestScannerRecognizesCellFlavors guards the external scanner in src/scanner.c:
+// every notebook flavor this grammar claims to support should at least parse
+// without the whole tree collapsing into ERROR.
+func TestScannerRecognizesCellFlavors(t *testing.T) {
+	fixtures := []string{
+		"percent.py",
+		"light.py",
+		"rmarkdown.Rmd",
+		"quarto.qmd",
+	}
+
+	parser := newParser(t)
+
+	for _, fixture := range fixtures {
+		t.Run(fixture, func(t *testing.T) {
+			src, err := os.ReadFile(filepath.Join("..", "..", "test", "fixtures", fixture))
+			if err != nil {
+				t.Fatalf("reading fixture: %v", err)
+			}
+
+			tree := parser.ParseCtx(context.Background(), src, nil)
+			defer tree.Close()
+
+			if tree.RootNode().HasError() {
+				t.Errorf("%s: %s", fixture, tree.RootNode().ToSexp())
+			}
+		})
+	}
+}
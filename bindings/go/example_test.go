@@ -0,0 +1,73 @@
+package tree_sitter_notebook_test
+
+import (
+	"context"
+	"fmt"
+
+	tree_sitter_notebook "github.com/koalazub/nothelix/bindings/go"
+	tree_sitter_notebook_inline "github.com/koalazub/nothelix/notebook-inline/bindings/go"
+	sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_python "github.com/tree-sitter/tree-sitter-python/bindings/go"
+)
+
+// Example_multiParser shows the shape an editor's highlighter would follow: parse
+// the notebook's block structure once, then re-parse each cell's byte range with
+// a parser picked by the cell's kind: notebook_inline for markdown cells, and
+// Python for every code cell (this example does not read a cell's metadata
+// language the way queries/injections.scm does).
+func Example_multiParser() {
+	source := []byte("# %% [markdown]\n# # Title\n# %%\nprint('hi')\n")
+
+	notebookParser := sitter.NewParser()
+	defer notebookParser.Close()
+	notebookParser.SetLanguage(sitter.NewLanguage(tree_sitter_notebook.Language()))
+
+	tree := notebookParser.ParseCtx(context.Background(), source, nil)
+	defer tree.Close()
+
+	inlineLang := sitter.NewLanguage(tree_sitter_notebook_inline.Language())
+	pythonLang := sitter.NewLanguage(tree_sitter_python.Language())
+
+	root := tree.RootNode()
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		cell := root.NamedChild(i)
+		if cell == nil || cell.Kind() != "cell" {
+			continue
+		}
+
+		content := cell.ChildByFieldName("content")
+		if content == nil {
+			continue
+		}
+
+		// A cell with no "kind" field at all (a bare "# %%", a light-format
+		// "# +", or a fenced chunk) is always a code cell - only Jupytext
+		// percent cells spell the kind out explicitly.
+		kind := "code"
+		if kindNode := cell.ChildByFieldName("kind"); kindNode != nil {
+			kind = kindNode.Utf8Text(source)
+		}
+
+		cellSource := source[content.StartByte():content.EndByte()]
+
+		cellParser := sitter.NewParser()
+		switch kind {
+		case "markdown":
+			cellParser.SetLanguage(inlineLang)
+		case "code":
+			cellParser.SetLanguage(pythonLang)
+		default:
+			cellParser.Close()
+			continue
+		}
+
+		cellTree := cellParser.ParseCtx(context.Background(), cellSource, nil)
+		fmt.Println(cellTree.RootNode().Kind())
+		cellTree.Close()
+		cellParser.Close()
+	}
+
+	// Output:
+	// inline
+	// module
+}
@@ -0,0 +1,87 @@
+package notebook
+
+import (
+	"context"
+	"fmt"
+
+	sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Kind is the notebook cell kind, as reported by the scanner's cell_type_marker
+// token (see src/scanner.c).
+type Kind string
+
+const (
+	KindCode     Kind = "code"
+	KindMarkdown Kind = "markdown"
+	KindRaw      Kind = "raw"
+)
+
+// Cell is one notebook cell: its kind, the language its source is written in (when
+// known), its raw source bytes, and whatever metadata the notebook format attached
+// to it.
+type Cell struct {
+	Kind     Kind
+	Language string
+	Source   []byte
+	Metadata []byte
+
+	StartByte uint
+	EndByte   uint
+}
+
+func newCell(node *sitter.Node, src []byte) (*Cell, error) {
+	contentNode := node.ChildByFieldName("content")
+	if contentNode == nil {
+		return nil, fmt.Errorf("notebook: malformed cell node %s", node.ToSexp())
+	}
+
+	// Only the Jupytext percent format spells out a cell's kind explicitly
+	// (`# %% [markdown]`, `# %% [raw]`); every other marker has no kind field
+	// at all, and that always means a code cell.
+	kind := KindCode
+	if kindNode := node.ChildByFieldName("kind"); kindNode != nil {
+		kind = Kind(kindNode.Utf8Text(src))
+	}
+
+	cell := &Cell{
+		Kind:      kind,
+		Source:    src[contentNode.StartByte():contentNode.EndByte()],
+		StartByte: contentNode.StartByte(),
+		EndByte:   contentNode.EndByte(),
+	}
+
+	if metaNode := node.ChildByFieldName("metadata"); metaNode != nil {
+		if langNode := metaNode.ChildByFieldName("language"); langNode != nil {
+			cell.Language = langNode.Utf8Text(src)
+		}
+		if bodyNode := metaNode.ChildByFieldName("body"); bodyNode != nil {
+			cell.Metadata = []byte(bodyNode.Utf8Text(src))
+		}
+	}
+
+	return cell, nil
+}
+
+// newProseCell wraps a top-level `prose` node - R Markdown/Quarto's narrative
+// text between chunks - as a markdown Cell. Unlike `cell`, `prose` has no
+// kind/metadata fields to read: its only child is a `prose_content` node
+// covering the same bytes.
+func newProseCell(node *sitter.Node, src []byte) *Cell {
+	return &Cell{
+		Kind:      KindMarkdown,
+		Source:    src[node.StartByte():node.EndByte()],
+		StartByte: node.StartByte(),
+		EndByte:   node.EndByte(),
+	}
+}
+
+// ParseInner feeds just this cell's source bytes into parser, so callers never
+// have to compute byte offsets into the parent document themselves.
+func (c *Cell) ParseInner(parser *sitter.Parser) (*sitter.Tree, error) {
+	tree := parser.ParseCtx(context.Background(), c.Source, nil)
+	if tree == nil {
+		return nil, fmt.Errorf("notebook: parsing cell contents failed")
+	}
+	return tree, nil
+}
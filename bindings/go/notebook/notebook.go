@@ -0,0 +1,75 @@
+// Package notebook provides an ergonomic Go API over the raw tree-sitter-notebook
+// grammar in github.com/koalazub/nothelix/bindings/go. Callers who just want a
+// list of cells and their source text should reach for this package rather than
+// walking the syntax tree themselves.
+package notebook
+
+import (
+	"context"
+	"fmt"
+
+	tree_sitter_notebook "github.com/koalazub/nothelix/bindings/go"
+	sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Notebook is a parsed notebook document: its cells, in source order.
+type Notebook struct {
+	Source []byte
+	Cells  []*Cell
+
+	tree *sitter.Tree
+}
+
+// Close releases the underlying tree-sitter tree. Callers should call this once
+// they're done with the Notebook and any Cell derived from it.
+func (n *Notebook) Close() {
+	if n.tree != nil {
+		n.tree.Close()
+	}
+}
+
+// Parse parses src as a notebook document and materializes its cells.
+func Parse(ctx context.Context, src []byte) (*Notebook, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+
+	if err := parser.SetLanguage(sitter.NewLanguage(tree_sitter_notebook.Language())); err != nil {
+		return nil, fmt.Errorf("notebook: set language: %w", err)
+	}
+
+	tree := parser.ParseCtx(ctx, src, nil)
+	if tree == nil {
+		return nil, fmt.Errorf("notebook: parse failed")
+	}
+
+	root := tree.RootNode()
+	nb := &Notebook{Source: src, tree: tree}
+
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		node := root.NamedChild(i)
+		if node == nil {
+			continue
+		}
+
+		var cell *Cell
+		var err error
+		switch node.Kind() {
+		case "cell":
+			cell, err = newCell(node, src)
+		case "prose":
+			// R Markdown/Quarto's narrative text between chunks has no cell
+			// wrapper of its own - just a `prose` node around its content - but it's
+			// still markdown, so callers shouldn't have to special-case it.
+			cell = newProseCell(node, src)
+		default:
+			continue
+		}
+		if err != nil {
+			nb.Close()
+			return nil, err
+		}
+		nb.Cells = append(nb.Cells, cell)
+	}
+
+	return nb, nil
+}
@@ -0,0 +1,95 @@
+package notebook_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/koalazub/nothelix/bindings/go/notebook"
+)
+
+func TestParseSplitsCells(t *testing.T) {
+	src := []byte("# %% [markdown]\n# Title\n# %%\nprint('hi')\n")
+
+	nb, err := notebook.Parse(context.Background(), src)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	defer nb.Close()
+
+	if len(nb.Cells) != 2 {
+		t.Fatalf("got %d cells, want 2", len(nb.Cells))
+	}
+	if nb.Cells[0].Kind != notebook.KindMarkdown {
+		t.Errorf("cell 0 kind = %q, want markdown", nb.Cells[0].Kind)
+	}
+	if nb.Cells[1].Kind != notebook.KindCode {
+		t.Errorf("cell 1 kind = %q, want code", nb.Cells[1].Kind)
+	}
+}
+
+func TestParseIncludesProseBetweenChunks(t *testing.T) {
+	src := []byte("# Title\n\nSome narrative.\n\n```{r}\nplot(1)\n```\n\nMore narrative.\n")
+
+	nb, err := notebook.Parse(context.Background(), src)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	defer nb.Close()
+
+	if len(nb.Cells) != 3 {
+		t.Fatalf("got %d cells, want 3 (prose, chunk, prose)", len(nb.Cells))
+	}
+	if nb.Cells[0].Kind != notebook.KindMarkdown {
+		t.Errorf("cell 0 kind = %q, want markdown", nb.Cells[0].Kind)
+	}
+	if nb.Cells[1].Kind != notebook.KindCode {
+		t.Errorf("cell 1 kind = %q, want code", nb.Cells[1].Kind)
+	}
+	if nb.Cells[2].Kind != notebook.KindMarkdown {
+		t.Errorf("cell 2 kind = %q, want markdown", nb.Cells[2].Kind)
+	}
+}
+
+func TestParseReadsCellLanguageFromMetadata(t *testing.T) {
+	src := []byte("```{python}\nprint('hi')\n```\n")
+
+	nb, err := notebook.Parse(context.Background(), src)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	defer nb.Close()
+
+	if len(nb.Cells) != 1 {
+		t.Fatalf("got %d cells, want 1", len(nb.Cells))
+	}
+	if got := nb.Cells[0].Language; got != "python" {
+		t.Errorf("cell 0 language = %q, want %q", got, "python")
+	}
+}
+
+func TestCellsByLanguageFiltersByMetadata(t *testing.T) {
+	src := []byte("```{python}\nprint('hi')\n```\n\n```{r}\nplot(1)\n```\n")
+
+	nb, err := notebook.Parse(context.Background(), src)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	defer nb.Close()
+
+	cells, err := nb.CellsByLanguage("r")
+	if err != nil {
+		t.Fatalf("CellsByLanguage: %v", err)
+	}
+	if len(cells) != 1 {
+		t.Fatalf("got %d cells, want 1", len(cells))
+	}
+	if cells[0] != nb.Cells[1] {
+		t.Errorf("CellsByLanguage(\"r\") returned a different *Cell than nb.Cells[1]")
+	}
+
+	if cells, err := nb.CellsByLanguage("julia"); err != nil {
+		t.Fatalf("CellsByLanguage: %v", err)
+	} else if len(cells) != 0 {
+		t.Errorf("got %d cells for an absent language, want 0", len(cells))
+	}
+}
@@ -0,0 +1,69 @@
+package notebook
+
+import (
+	"fmt"
+
+	tree_sitter_notebook "github.com/koalazub/nothelix/bindings/go"
+	sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// cellLanguageQuery captures a cell together with its declared language, so
+// CellsByLanguage can find both in a single query pass over the tree.
+const cellLanguageQuery = `
+(cell
+  metadata: (cell_metadata language: (language) @language)) @cell
+`
+
+// CellsByLanguage returns the notebook's code cells whose metadata declares the
+// given language (e.g. "python", "r", "julia"). It compiles and runs a
+// sitter.Query rather than re-walking named children, so it's the preferred way
+// to filter cells once a Notebook has more than a handful.
+func (n *Notebook) CellsByLanguage(lang string) ([]*Cell, error) {
+	language := sitter.NewLanguage(tree_sitter_notebook.Language())
+
+	query, err := sitter.NewQuery(language, cellLanguageQuery)
+	if err != nil {
+		return nil, fmt.Errorf("notebook: compiling query: %w", err)
+	}
+	defer query.Close()
+
+	cursor := sitter.NewQueryCursor()
+	defer cursor.Close()
+
+	byStartByte := make(map[uint]*Cell, len(n.Cells))
+	for _, cell := range n.Cells {
+		byStartByte[cell.StartByte] = cell
+	}
+
+	var matched []*Cell
+	matches := cursor.Matches(query, n.tree.RootNode(), n.Source)
+	for match := matches.Next(); match != nil; match = matches.Next() {
+		var cellNode *sitter.Node
+		var language string
+
+		for _, capture := range match.Captures {
+			name := query.CaptureNames()[capture.Index]
+			switch name {
+			case "cell":
+				node := capture.Node
+				cellNode = &node
+			case "language":
+				language = capture.Node.Utf8Text(n.Source)
+			}
+		}
+
+		if cellNode == nil || language != lang {
+			continue
+		}
+		contentNode := cellNode.ChildByFieldName("content")
+		if contentNode == nil {
+			continue
+		}
+
+		if cell, ok := byStartByte[contentNode.StartByte()]; ok {
+			matched = append(matched, cell)
+		}
+	}
+
+	return matched, nil
+}
@@ -0,0 +1,18 @@
+package tree_sitter_notebook_inline
+
+// #cgo CFLAGS: -std=c11 -fPIC
+// #include "../../src/parser.c"
+// #include "../../src/scanner.c"
+// typedef struct TSLanguage TSLanguage;
+// extern const TSLanguage *tree_sitter_notebook_inline(void);
+import "C"
+
+import "unsafe"
+
+// Language returns the tree-sitter language for notebook markdown-cell prose.
+//
+// It is meant to be used as an injection target for markdown cells parsed by
+// github.com/koalazub/nothelix/bindings/go, not parsed standalone.
+func Language() unsafe.Pointer {
+	return unsafe.Pointer(C.tree_sitter_notebook_inline())
+}
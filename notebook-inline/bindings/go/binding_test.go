@@ -0,0 +1,67 @@
+package tree_sitter_notebook_inline_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tree_sitter_notebook_inline "github.com/koalazub/nothelix/notebook-inline/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func TestCanLoadGrammar(t *testing.T) {
+	language := tree_sitter.NewLanguage(tree_sitter_notebook_inline.Language())
+	if language == nil {
+		t.Errorf("Error loading NotebookInline grammar")
+	}
+}
+
+func newParser(t *testing.T) *tree_sitter.Parser {
+	t.Helper()
+	parser := tree_sitter.NewParser()
+	t.Cleanup(parser.Close)
+	if err := parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_notebook_inline.Language())); err != nil {
+		t.Fatalf("SetLanguage: %v", err)
+	}
+	return parser
+}
+
+// TestParseEmphasisAndStrong guards the open/close disambiguation in
+// src/scanner.c: emphasis and strong both recur through _inline_element, so a
+// closing delimiter has to win over starting a new nested span at the same
+// position, or every one of these collapses into an unclosed ERROR.
+func TestParseEmphasisAndStrong(t *testing.T) {
+	tests := []struct {
+		name         string
+		src          string
+		wantEmphasis int
+		wantStrong   int
+	}{
+		{name: "emphasis", src: "*a*", wantEmphasis: 1},
+		{name: "strong", src: "**a**", wantStrong: 1},
+		{name: "underscore emphasis", src: "_a_", wantEmphasis: 1},
+		{name: "underscore strong", src: "__a__", wantStrong: 1},
+		{name: "two separate emphasis spans", src: "*a* *b*", wantEmphasis: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := newParser(t)
+			tree := parser.ParseCtx(context.Background(), []byte(tt.src), nil)
+			defer tree.Close()
+
+			root := tree.RootNode()
+			if root.HasError() {
+				t.Fatalf("unexpected ERROR node:\n%s", root.ToSexp())
+			}
+
+			sexp := root.ToSexp()
+			if got := strings.Count(sexp, "(emphasis "); got != tt.wantEmphasis {
+				t.Errorf("%s: got %d emphasis nodes in %s, want %d", tt.src, got, sexp, tt.wantEmphasis)
+			}
+			if got := strings.Count(sexp, "(strong "); got != tt.wantStrong {
+				t.Errorf("%s: got %d strong nodes in %s, want %d", tt.src, got, sexp, tt.wantStrong)
+			}
+		})
+	}
+}